@@ -0,0 +1,110 @@
+// Command telemetry_client dials the telemetry gRPC server and exercises
+// QueryTelemetry for every known TelemetryType.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+
+	"github.com/v-saba/bazel-tutorial/common/observability"
+)
+
+var (
+	serverAddr = flag.String("server-addr", "localhost:50051", "address of the telemetry gRPC server")
+	requestTO  = flag.Duration("request-timeout", 5*time.Second, "per-RPC timeout")
+
+	tlsCertFile   = flag.String("tls-cert", "", "path to the client TLS certificate, for mutual TLS")
+	tlsKeyFile    = flag.String("tls-key", "", "path to the client TLS private key, for mutual TLS")
+	tlsServerCA   = flag.String("tls-server-ca", "", "path to a CA bundle used to verify the server certificate (enables TLS when set)")
+	tlsServerName = flag.String("tls-server-name", "", "override the server name used during TLS verification")
+
+	keepaliveTime    = flag.Duration("keepalive-time", 30*time.Second, "interval between keepalive pings the client sends")
+	keepaliveTimeout = flag.Duration("keepalive-timeout", 10*time.Second, "time to wait for a keepalive ping ack before considering the connection dead")
+)
+
+func loadClientTransportCredentials() (credentials.TransportCredentials, error) {
+	if *tlsServerCA == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	caPEM, err := os.ReadFile(*tlsServerCA)
+	if err != nil {
+		return nil, fmt.Errorf("read server CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("append server CA from %s", *tlsServerCA)
+	}
+
+	cfg := &tls.Config{
+		RootCAs:    pool,
+		ServerName: *tlsServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if *tlsCertFile != "" || *tlsKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(*tlsCertFile, *tlsKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client key pair: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(cfg), nil
+}
+
+func main() {
+	flag.Parse()
+
+	creds, err := loadClientTransportCredentials()
+	if err != nil {
+		log.Fatalf("Failed to set up TLS: %v", err)
+	}
+
+	conn, err := grpc.NewClient(*serverAddr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                *keepaliveTime,
+			Timeout:             *keepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithChainUnaryInterceptor(observability.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to dial %s: %v", *serverAddr, err)
+	}
+	defer conn.Close()
+
+	client := pb.NewTelemetryServiceClient(conn)
+
+	telemetryTypes := []pb.TelemetryType{
+		pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT,
+		pb.TelemetryType_TELEMETRY_TYPE_LOG,
+		pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE,
+		pb.TelemetryType_TELEMETRY_TYPE_MEMORY,
+	}
+
+	for _, telType := range telemetryTypes {
+		ctx, cancel := context.WithTimeout(context.Background(), *requestTO)
+		resp, err := client.QueryTelemetry(ctx, &pb.TelemetryRequest{TelemetryType: telType})
+		cancel()
+		if err != nil {
+			log.Printf("QueryTelemetry(%v) failed: %v", telType, err)
+			continue
+		}
+		log.Printf("QueryTelemetry(%v) -> %v", telType, resp)
+	}
+}
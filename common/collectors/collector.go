@@ -0,0 +1,18 @@
+// Package collectors produces telemetry samples for the telemetry server.
+// Each Collector owns one TelemetryType; the server looks them up by type
+// instead of branching on it directly.
+package collectors
+
+import (
+	"context"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+// Collector produces a single telemetry sample.
+type Collector interface {
+	Collect(ctx context.Context) (*pb.TelemetryResponse, error)
+}
+
+// Registry maps each TelemetryType to the Collector that produces it.
+type Registry map[pb.TelemetryType]Collector
@@ -0,0 +1,75 @@
+package collectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+func TestLogCollectorCollect(t *testing.T) {
+	resp, err := LogCollector{}.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	if resp.TelemetryType != pb.TelemetryType_TELEMETRY_TYPE_LOG {
+		t.Fatalf("TelemetryType = %v, want %v", resp.TelemetryType, pb.TelemetryType_TELEMETRY_TYPE_LOG)
+	}
+	if resp.GetLogTelemetry().GetLogData() == "" {
+		t.Fatal("LogData is empty")
+	}
+}
+
+func TestHeartbeatCollectorCollect(t *testing.T) {
+	c := NewHeartbeatCollector()
+
+	first, err := c.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	second, err := c.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+
+	if first.TelemetryType != pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT {
+		t.Fatalf("TelemetryType = %v, want %v", first.TelemetryType, pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT)
+	}
+	if first.GetHeartbeatTelemetry().GetHostname() == "" {
+		t.Fatal("Hostname is empty")
+	}
+	if second.GetHeartbeatTelemetry().GetSequence() != first.GetHeartbeatTelemetry().GetSequence()+1 {
+		t.Fatalf("Sequence = %d, want %d", second.GetHeartbeatTelemetry().GetSequence(), first.GetHeartbeatTelemetry().GetSequence()+1)
+	}
+}
+
+func TestCPUCollectorCollect(t *testing.T) {
+	c := &CPUCollector{SampleInterval: 10 * time.Millisecond}
+
+	resp, err := c.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	if resp.TelemetryType != pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE {
+		t.Fatalf("TelemetryType = %v, want %v", resp.TelemetryType, pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE)
+	}
+	if resp.GetCpuUsageTelemetry().GetCpuUsage() < 0 {
+		t.Fatalf("CpuUsage = %v, want >= 0", resp.GetCpuUsageTelemetry().GetCpuUsage())
+	}
+}
+
+func TestMemoryCollectorCollect(t *testing.T) {
+	c := NewMemoryCollector()
+
+	resp, err := c.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	if resp.TelemetryType != pb.TelemetryType_TELEMETRY_TYPE_MEMORY {
+		t.Fatalf("TelemetryType = %v, want %v", resp.TelemetryType, pb.TelemetryType_TELEMETRY_TYPE_MEMORY)
+	}
+	if resp.GetMemoryTelemetry().GetHostTotalBytes() == 0 {
+		t.Fatal("HostTotalBytes = 0, want > 0")
+	}
+}
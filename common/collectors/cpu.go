@@ -0,0 +1,52 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+// CPUCollector samples overall CPU utilization over SampleInterval.
+type CPUCollector struct {
+	// SampleInterval is how long cpu.Percent blocks measuring utilization.
+	// Zero uses a sane default.
+	SampleInterval time.Duration
+}
+
+const defaultCPUSampleInterval = 200 * time.Millisecond
+
+// Collect implements Collector.
+func (c *CPUCollector) Collect(ctx context.Context) (*pb.TelemetryResponse, error) {
+	interval := c.SampleInterval
+	if interval <= 0 {
+		interval = defaultCPUSampleInterval
+	}
+
+	percents, err := cpu.PercentWithContext(ctx, interval, false)
+	if err != nil {
+		return nil, fmt.Errorf("sample cpu usage: %w", err)
+	}
+	if len(percents) == 0 {
+		return nil, fmt.Errorf("sample cpu usage: no samples returned")
+	}
+
+	trace.SpanFromContext(ctx).AddEvent("cpu.sampled", trace.WithAttributes(
+		attribute.Float64("cpu.usage_percent", percents[0]),
+	))
+
+	return &pb.TelemetryResponse{
+		TelemetryType: pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE,
+		Timestamp:     time.Now().Unix(),
+		TelemetryData: &pb.TelemetryResponse_CpuUsageTelemetry{
+			CpuUsageTelemetry: &pb.CpuUsageTelemetry{
+				CpuUsage: percents[0],
+			},
+		},
+	}, nil
+}
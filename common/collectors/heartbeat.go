@@ -0,0 +1,51 @@
+package collectors
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+// HeartbeatCollector reports process liveness: uptime since it was created,
+// hostname, PID, Go runtime version, and a per-instance sequence number.
+type HeartbeatCollector struct {
+	startedAt time.Time
+	hostname  string
+	sequence  uint64
+}
+
+// NewHeartbeatCollector creates a HeartbeatCollector whose uptime is
+// measured from the call to NewHeartbeatCollector.
+func NewHeartbeatCollector() *HeartbeatCollector {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return &HeartbeatCollector{
+		startedAt: time.Now(),
+		hostname:  hostname,
+	}
+}
+
+// Collect implements Collector.
+func (c *HeartbeatCollector) Collect(ctx context.Context) (*pb.TelemetryResponse, error) {
+	seq := atomic.AddUint64(&c.sequence, 1)
+
+	return &pb.TelemetryResponse{
+		TelemetryType: pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT,
+		Timestamp:     time.Now().Unix(),
+		TelemetryData: &pb.TelemetryResponse_HeartbeatTelemetry{
+			HeartbeatTelemetry: &pb.HeartbeatTelemetry{
+				UptimeSeconds: int64(time.Since(c.startedAt).Seconds()),
+				Hostname:      c.hostname,
+				Pid:           int32(os.Getpid()),
+				GoVersion:     runtime.Version(),
+				Sequence:      seq,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,25 @@
+package collectors
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+// LogCollector returns a fixed line of log telemetry. Real log forwarding
+// will eventually replace this with a tail of the server's own log sink.
+type LogCollector struct{}
+
+// Collect implements Collector.
+func (LogCollector) Collect(ctx context.Context) (*pb.TelemetryResponse, error) {
+	return &pb.TelemetryResponse{
+		TelemetryType: pb.TelemetryType_TELEMETRY_TYPE_LOG,
+		Timestamp:     time.Now().Unix(),
+		TelemetryData: &pb.TelemetryResponse_LogTelemetry{
+			LogTelemetry: &pb.LogTelemetry{
+				LogData: "Sample log data from gRPC server",
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,65 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/process"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+// MemoryCollector samples process heap usage (via runtime.MemStats), process
+// RSS, and host-wide memory usage (via gopsutil).
+type MemoryCollector struct {
+	pid int32
+}
+
+// NewMemoryCollector creates a MemoryCollector for the current process.
+func NewMemoryCollector() *MemoryCollector {
+	return &MemoryCollector{pid: int32(os.Getpid())}
+}
+
+// Collect implements Collector.
+func (c *MemoryCollector) Collect(ctx context.Context) (*pb.TelemetryResponse, error) {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	proc, err := process.NewProcessWithContext(ctx, c.pid)
+	if err != nil {
+		return nil, fmt.Errorf("open process %d: %w", c.pid, err)
+	}
+	procMem, err := proc.MemoryInfoWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read process memory info: %w", err)
+	}
+
+	hostMem, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read host memory info: %w", err)
+	}
+
+	trace.SpanFromContext(ctx).AddEvent("memory.sampled", trace.WithAttributes(
+		attribute.Int64("memory.process_rss_bytes", int64(procMem.RSS)),
+	))
+
+	return &pb.TelemetryResponse{
+		TelemetryType: pb.TelemetryType_TELEMETRY_TYPE_MEMORY,
+		Timestamp:     time.Now().Unix(),
+		TelemetryData: &pb.TelemetryResponse_MemoryTelemetry{
+			MemoryTelemetry: &pb.MemoryTelemetry{
+				ProcessRssBytes: procMem.RSS,
+				HeapAllocBytes:  stats.HeapAlloc,
+				HeapSysBytes:    stats.HeapSys,
+				HostTotalBytes:  hostMem.Total,
+				HostUsedBytes:   hostMem.Used,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// Config controls how New builds a *zap.Logger: log level, the rotating
+// file sink, and whether log lines are also mirrored to the console.
+type Config struct {
+	Level string `mapstructure:"level"`
+
+	Filename   string `mapstructure:"filename"`
+	MaxSizeMB  int    `mapstructure:"max_size_mb"`
+	MaxBackups int    `mapstructure:"max_backups"`
+	MaxAgeDays int    `mapstructure:"max_age_days"`
+	Compress   bool   `mapstructure:"compress"`
+
+	Console bool `mapstructure:"console"`
+}
+
+// DefaultConfig returns the configuration used when no config file is
+// supplied.
+func DefaultConfig() Config {
+	return Config{
+		Level:      "info",
+		Filename:   "telemetry_server.log",
+		MaxSizeMB:  100,
+		MaxBackups: 5,
+		MaxAgeDays: 28,
+		Compress:   true,
+		Console:    true,
+	}
+}
+
+// LoadConfig reads a YAML logger configuration from path, falling back to
+// DefaultConfig for any field the file does not set.
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, fmt.Errorf("read logger config %s: %w", path, err)
+	}
+	if err := v.Unmarshal(&cfg); err != nil {
+		return Config{}, fmt.Errorf("parse logger config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/v-saba/bazel-tutorial/common"
+)
+
+// RequestIDMetadataKey is the gRPC metadata key used to carry the request
+// ID on both the incoming request (if the caller already has one) and the
+// outgoing response.
+const RequestIDMetadataKey = "x-request-id"
+
+// UnaryServerInterceptor extracts the request ID from incoming metadata, or
+// generates one via common.GenerateUUIDStr if the caller didn't send one,
+// attaches it to base as a zap.Field, stores the resulting logger in the
+// request context, and echoes the ID back as response metadata.
+func UnaryServerInterceptor(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := requestIDFromIncoming(ctx)
+
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, requestID)); err != nil {
+			base.Warn("failed to set request ID response header", zap.Error(err))
+		}
+
+		reqLogger := base.With(zap.String("request_id", requestID), zap.String("method", info.FullMethod))
+		ctx = NewContext(ctx, reqLogger)
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming-RPC counterpart to
+// UnaryServerInterceptor: it extracts or generates the request ID, attaches
+// it to base as a zap.Field, stores the resulting logger in the stream's
+// context, and echoes the ID back as response metadata.
+func StreamServerInterceptor(base *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		requestID := requestIDFromIncoming(ctx)
+
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, requestID)); err != nil {
+			base.Warn("failed to set request ID response header", zap.Error(err))
+		}
+
+		reqLogger := base.With(zap.String("request_id", requestID), zap.String("method", info.FullMethod))
+		wrapped := &loggingServerStream{ServerStream: ss, ctx: NewContext(ctx, reqLogger)}
+
+		return handler(srv, wrapped)
+	}
+}
+
+// loggingServerStream wraps a grpc.ServerStream, overriding Context() so
+// handlers see the logger StreamServerInterceptor stored there.
+type loggingServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggingServerStream) Context() context.Context { return s.ctx }
+
+func requestIDFromIncoming(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDMetadataKey); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return common.GenerateUUIDStr()
+}
@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestIDFromIncomingUsesExistingHeader(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "existing-id"))
+
+	if got := requestIDFromIncoming(ctx); got != "existing-id" {
+		t.Fatalf("requestIDFromIncoming = %q, want %q", got, "existing-id")
+	}
+}
+
+func TestRequestIDFromIncomingGeneratesWhenMissing(t *testing.T) {
+	first := requestIDFromIncoming(context.Background())
+	if first == "" {
+		t.Fatal("requestIDFromIncoming returned an empty ID")
+	}
+
+	second := requestIDFromIncoming(context.Background())
+	if second == first {
+		t.Fatalf("requestIDFromIncoming returned the same ID twice: %q", first)
+	}
+}
+
+func TestUnaryServerInterceptorStoresRequestScopedLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	base := zap.New(core)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "req-1"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/svc/Method"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		FromContext(ctx).Info("handled")
+		return "ok", nil
+	}
+
+	resp, err := UnaryServerInterceptor(base)(ctx, nil, info, handler)
+	if err != nil {
+		t.Fatalf("interceptor error: %v", err)
+	}
+	if resp != "ok" {
+		t.Fatalf("resp = %v, want %q", resp, "ok")
+	}
+
+	entries := logs.FilterMessage("handled").All()
+	if len(entries) != 1 {
+		t.Fatalf("logged %d \"handled\" entries, want 1", len(entries))
+	}
+
+	fields := entries[0].ContextMap()
+	if fields["request_id"] != "req-1" {
+		t.Fatalf("request_id field = %v, want %q", fields["request_id"], "req-1")
+	}
+	if fields["method"] != info.FullMethod {
+		t.Fatalf("method field = %v, want %q", fields["method"], info.FullMethod)
+	}
+}
@@ -0,0 +1,44 @@
+// Package logger builds the zap.Logger used across the telemetry server: a
+// rotating file sink via lumberjack, an optional console encoder, and a
+// gRPC interceptor that tags every log line with a per-request correlation
+// ID.
+package logger
+
+import (
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// New builds a *zap.Logger from cfg. The file sink always rotates via
+// lumberjack; the console encoder is added only when cfg.Console is set.
+func New(cfg Config) (*zap.Logger, error) {
+	level, err := zapcore.ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, fmt.Errorf("parse log level %q: %w", cfg.Level, err)
+	}
+
+	encoderCfg := zap.NewProductionEncoderConfig()
+	encoderCfg.TimeKey = "timestamp"
+	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	fileSink := zapcore.AddSync(&lumberjack.Logger{
+		Filename:   cfg.Filename,
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAgeDays,
+		Compress:   cfg.Compress,
+	})
+
+	cores := []zapcore.Core{
+		zapcore.NewCore(zapcore.NewJSONEncoder(encoderCfg), fileSink, level),
+	}
+	if cfg.Console {
+		cores = append(cores, zapcore.NewCore(zapcore.NewConsoleEncoder(encoderCfg), zapcore.AddSync(os.Stdout), level))
+	}
+
+	return zap.New(zapcore.NewTee(cores...), zap.AddCaller()), nil
+}
@@ -0,0 +1,152 @@
+package observability
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+// telemetryTyped is satisfied by request messages that carry a
+// TelemetryType, letting the interceptor label metrics and spans without
+// a type switch per RPC.
+type telemetryTyped interface {
+	GetTelemetryType() pb.TelemetryType
+}
+
+// UnaryServerInterceptor starts a span for every unary call, tags it and
+// the associated Prometheus metrics with the gRPC method and (when the
+// request carries one) TelemetryType, and propagates the span through ctx
+// so collectors can add their own events.
+func UnaryServerInterceptor(m *Metrics) grpc.UnaryServerInterceptor {
+	tracer := otel.Tracer(TracerName)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		telType := "unspecified"
+		if typed, ok := req.(telemetryTyped); ok {
+			telType = typed.GetTelemetryType().String()
+		}
+
+		ctx, span := tracer.Start(ctx, info.FullMethod, trace.WithAttributes(
+			attribute.String("rpc.method", info.FullMethod),
+			attribute.String("telemetry.type", telType),
+		))
+		defer span.End()
+
+		m.IncInFlight(info.FullMethod)
+		defer m.DecInFlight(info.FullMethod)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		duration := time.Since(start)
+
+		code := status.Code(err)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		m.ObserveRequest(info.FullMethod, telType, code.String(), duration)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor starts a span covering the lifetime of every
+// streaming call and tags it and the associated Prometheus metrics with the
+// gRPC method and, once a message carrying one arrives, TelemetryType. The
+// span is propagated through the stream's context so collectors can add
+// their own events, the same as UnaryServerInterceptor does for unary
+// calls.
+func StreamServerInterceptor(m *Metrics) grpc.StreamServerInterceptor {
+	tracer := otel.Tracer(TracerName)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := tracer.Start(ss.Context(), info.FullMethod, trace.WithAttributes(
+			attribute.String("rpc.method", info.FullMethod),
+		))
+		defer span.End()
+
+		m.IncInFlight(info.FullMethod)
+		defer m.DecInFlight(info.FullMethod)
+
+		wrapped := &tracedServerStream{ServerStream: ss, ctx: ctx}
+
+		start := time.Now()
+		err := handler(srv, wrapped)
+		duration := time.Since(start)
+
+		code := status.Code(err)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		telType := wrapped.telemetryType()
+		span.SetAttributes(attribute.String("telemetry.type", telType))
+		m.ObserveRequest(info.FullMethod, telType, code.String(), duration)
+		return err
+	}
+}
+
+// tracedServerStream wraps a grpc.ServerStream to override Context() with
+// one carrying the call's span, and to remember the TelemetryType of the
+// first message it sees so StreamServerInterceptor can label the span and
+// metrics once the stream ends.
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	mu      sync.Mutex
+	telType string
+}
+
+func (s *tracedServerStream) Context() context.Context { return s.ctx }
+
+func (s *tracedServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.mu.Lock()
+		if s.telType == "" {
+			if typed, ok := m.(telemetryTyped); ok {
+				s.telType = typed.GetTelemetryType().String()
+			}
+		}
+		s.mu.Unlock()
+	}
+	return err
+}
+
+func (s *tracedServerStream) telemetryType() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.telType == "" {
+		return "unspecified"
+	}
+	return s.telType
+}
+
+// UnaryClientInterceptor starts a client-side span for every outbound
+// unary call so the resulting trace covers both sides of the RPC.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	tracer := otel.Tracer(TracerName)
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := tracer.Start(ctx, method, trace.WithAttributes(attribute.String("rpc.method", method)))
+		defer span.End()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	}
+}
@@ -0,0 +1,91 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+func newTestMetrics(t *testing.T) *Metrics {
+	t.Helper()
+	return NewMetrics(prometheus.NewRegistry())
+}
+
+func TestUnaryServerInterceptorLabelsSpanAndMetrics(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevTP := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prevTP)
+
+	m := newTestMetrics(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/svc/QueryTelemetry"}
+	req := &pb.TelemetryRequest{TelemetryType: pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	resp, err := UnaryServerInterceptor(m)(context.Background(), req, info, handler)
+	if err != nil {
+		t.Fatalf("interceptor error: %v", err)
+	}
+	if resp != "ok" {
+		t.Fatalf("resp = %v, want %q", resp, "ok")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("ended spans = %d, want 1", len(spans))
+	}
+	if spans[0].Name() != info.FullMethod {
+		t.Fatalf("span name = %q, want %q", spans[0].Name(), info.FullMethod)
+	}
+
+	if count := testutil.CollectAndCount(m.requestsTotal); count != 1 {
+		t.Fatalf("requestsTotal series = %d, want 1", count)
+	}
+	if got := testutil.ToFloat64(m.requestsTotal.WithLabelValues(info.FullMethod, "TELEMETRY_TYPE_CPU_USAGE", "OK")); got != 1 {
+		t.Fatalf("requestsTotal = %v, want 1", got)
+	}
+}
+
+func TestUnaryServerInterceptorRecordsErrorStatus(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevTP := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prevTP)
+
+	m := newTestMetrics(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/svc/QueryTelemetry"}
+
+	wantErr := errors.New("boom")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, wantErr
+	}
+
+	if _, err := UnaryServerInterceptor(m)(context.Background(), &pb.TelemetryRequest{}, info, handler); !errors.Is(err, wantErr) {
+		t.Fatalf("interceptor error = %v, want %v", err, wantErr)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("ended spans = %d, want 1", len(spans))
+	}
+	if got := spans[0].Status().Code; got != codes.Error {
+		t.Fatalf("span status code = %v, want %v", got, codes.Error)
+	}
+
+	if got := testutil.ToFloat64(m.requestsTotal.WithLabelValues(info.FullMethod, "TELEMETRY_TYPE_UNSPECIFIED", "Unknown")); got != 1 {
+		t.Fatalf("requestsTotal = %v, want 1", got)
+	}
+}
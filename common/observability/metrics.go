@@ -0,0 +1,74 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus instruments recorded around every gRPC call:
+// a request counter, a latency histogram, and an in-flight gauge, all
+// labeled by gRPC method and, where applicable, TelemetryType.
+type Metrics struct {
+	requestsTotal   *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	inFlight        *prometheus.GaugeVec
+}
+
+// NewMetrics registers the telemetry server's instruments on reg.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "telemetry_server_requests_total",
+			Help: "Total gRPC requests handled, labeled by method, telemetry_type, and status code.",
+		}, []string{"method", "telemetry_type", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "telemetry_server_request_duration_seconds",
+			Help:    "gRPC request latency in seconds, labeled by method and telemetry_type.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "telemetry_type"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "telemetry_server_requests_in_flight",
+			Help: "gRPC requests currently being handled, labeled by method.",
+		}, []string{"method"}),
+	}
+
+	reg.MustRegister(m.requestsTotal, m.requestDuration, m.inFlight)
+	return m
+}
+
+// ObserveRequest records one completed request.
+func (m *Metrics) ObserveRequest(method, telemetryType, code string, duration time.Duration) {
+	m.requestsTotal.WithLabelValues(method, telemetryType, code).Inc()
+	m.requestDuration.WithLabelValues(method, telemetryType).Observe(duration.Seconds())
+}
+
+// IncInFlight and DecInFlight track requests currently being handled.
+func (m *Metrics) IncInFlight(method string) { m.inFlight.WithLabelValues(method).Inc() }
+func (m *Metrics) DecInFlight(method string) { m.inFlight.WithLabelValues(method).Dec() }
+
+// ServeMetrics starts an HTTP server exposing /metrics on addr, using reg as
+// the Prometheus gatherer. It returns the *http.Server so the caller can
+// shut it down; serve errors other than http.ErrServerClosed are reported
+// via errCh.
+func ServeMetrics(addr string, reg *prometheus.Registry, errCh chan<- error) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+
+	srv := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("metrics server: %w", err)
+		}
+	}()
+	return srv
+}
+
+// ShutdownMetrics gracefully stops srv.
+func ShutdownMetrics(ctx context.Context, srv *http.Server) error {
+	return srv.Shutdown(ctx)
+}
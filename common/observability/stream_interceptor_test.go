@@ -0,0 +1,77 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+// fakeServerStream is a minimal grpc.ServerStream that returns reqs in order
+// from RecvMsg and otherwise no-ops, for exercising StreamServerInterceptor
+// without a real connection.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []interface{}
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	if len(s.reqs) == 0 {
+		return errors.New("no more messages")
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+
+	*m.(*pb.SubscribeTelemetryRequest) = *req.(*pb.SubscribeTelemetryRequest)
+	return nil
+}
+
+func TestStreamServerInterceptorLabelsFromFirstMessage(t *testing.T) {
+	m := newTestMetrics(t)
+	info := &grpc.StreamServerInfo{FullMethod: "/svc/SubscribeTelemetry"}
+
+	ss := &fakeServerStream{
+		ctx:  context.Background(),
+		reqs: []interface{}{&pb.SubscribeTelemetryRequest{TelemetryType: pb.TelemetryType_TELEMETRY_TYPE_MEMORY}},
+	}
+
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		var req pb.SubscribeTelemetryRequest
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if err := StreamServerInterceptor(m)(nil, ss, info, handler); err != nil {
+		t.Fatalf("interceptor error: %v", err)
+	}
+
+	if got := testutil.ToFloat64(m.requestsTotal.WithLabelValues(info.FullMethod, "TELEMETRY_TYPE_MEMORY", "OK")); got != 1 {
+		t.Fatalf("requestsTotal = %v, want 1", got)
+	}
+}
+
+func TestStreamServerInterceptorDefaultsToUnspecified(t *testing.T) {
+	m := newTestMetrics(t)
+	info := &grpc.StreamServerInfo{FullMethod: "/svc/TelemetryChannel"}
+
+	ss := &fakeServerStream{ctx: context.Background()}
+
+	handler := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+
+	if err := StreamServerInterceptor(m)(nil, ss, info, handler); err != nil {
+		t.Fatalf("interceptor error: %v", err)
+	}
+
+	if got := testutil.ToFloat64(m.requestsTotal.WithLabelValues(info.FullMethod, "unspecified", "OK")); got != 1 {
+		t.Fatalf("requestsTotal = %v, want 1", got)
+	}
+}
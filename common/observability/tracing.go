@@ -0,0 +1,47 @@
+// Package observability installs cross-cutting OpenTelemetry tracing and
+// Prometheus metrics around the telemetry server's gRPC methods.
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// TracerName identifies spans created by the telemetry server to
+// distinguish them from spans created by its dependencies.
+const TracerName = "github.com/v-saba/bazel-tutorial/telemetry_server"
+
+// InitTracerProvider builds a TracerProvider that exports spans to an OTLP
+// collector at endpoint, registers it as the global provider, and returns a
+// shutdown func the caller must invoke before exiting.
+func InitTracerProvider(ctx context.Context, serviceName, endpoint string) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("build OTLP trace exporter: %w", err)
+	}
+
+	res, err := sdkresource.New(ctx, sdkresource.WithAttributes(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("build OTel resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return tp.Shutdown, nil
+}
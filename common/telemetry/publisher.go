@@ -0,0 +1,241 @@
+// Package telemetry batches telemetry records produced by the telemetry
+// server and forwards them to an upstream collector, retrying failed
+// deliveries with bounded memory.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSendPeriod    = 5 * time.Second
+	defaultMaxRetryCache = 5000
+	defaultMaxBuffer     = 5000
+
+	maxBackoff = 30 * time.Second
+)
+
+// Logger is the minimal logging surface the publisher needs. *log.Logger
+// satisfies it.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Printf(string, ...interface{}) {}
+
+// Item is a single telemetry record queued for delivery upstream. ID is
+// assigned by Enqueue so the collector can dedupe redelivered items.
+type Item struct {
+	ID         uint64          `json:"id"`
+	Payload    json.RawMessage `json:"payload"`
+	EnqueuedAt time.Time       `json:"enqueued_at"`
+}
+
+// Option configures a Publisher.
+type Option func(*Publisher)
+
+// WithSendPeriod sets the interval between upstream flush attempts.
+func WithSendPeriod(d time.Duration) Option {
+	return func(p *Publisher) { p.sendPeriod = d }
+}
+
+// WithMaxRetryCache bounds how many failed items are held for retry. Once
+// full, the oldest retry item is dropped to make room for the newest.
+func WithMaxRetryCache(n int) Option {
+	return func(p *Publisher) { p.maxRetryCache = n }
+}
+
+// WithHTTPClient overrides the HTTP client used to reach the upstream
+// collector.
+func WithHTTPClient(c *http.Client) Option {
+	return func(p *Publisher) { p.httpClient = c }
+}
+
+// WithLogger overrides the logger used for delivery failures.
+func WithLogger(l Logger) Option {
+	return func(p *Publisher) { p.logger = l }
+}
+
+// Publisher batches enqueued items and forwards them to upstreamURL over
+// HTTP on a fixed period, retrying failed batches with exponential backoff.
+type Publisher struct {
+	upstreamURL   string
+	sendPeriod    time.Duration
+	maxRetryCache int
+	httpClient    *http.Client
+	logger        Logger
+
+	mu      sync.Mutex
+	nextID  uint64
+	pending []Item
+	maxBuf  int
+
+	retryMu    sync.Mutex
+	retryCache []Item
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewPublisher creates a Publisher that forwards batches to upstreamURL.
+// Call Start to begin the background flush loop.
+func NewPublisher(upstreamURL string, opts ...Option) *Publisher {
+	p := &Publisher{
+		upstreamURL:   upstreamURL,
+		sendPeriod:    defaultSendPeriod,
+		maxRetryCache: defaultMaxRetryCache,
+		maxBuf:        defaultMaxBuffer,
+		httpClient:    http.DefaultClient,
+		logger:        noopLogger{},
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Enqueue adds payload to the pending batch, dropping the oldest pending
+// item if the ring buffer is full. It returns the ID assigned to the item.
+func (p *Publisher) Enqueue(payload interface{}) (uint64, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("marshal telemetry payload: %w", err)
+	}
+
+	p.mu.Lock()
+	p.nextID++
+	id := p.nextID
+	item := Item{ID: id, Payload: raw, EnqueuedAt: time.Now()}
+
+	if len(p.pending) >= p.maxBuf {
+		p.pending = p.pending[1:]
+	}
+	p.pending = append(p.pending, item)
+	p.mu.Unlock()
+
+	return id, nil
+}
+
+// Start runs the background flush loop until ctx is done or Stop is called.
+func (p *Publisher) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.sendPeriod)
+	defer ticker.Stop()
+	defer close(p.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.flush(ctx)
+		}
+	}
+}
+
+// Stop signals the flush loop to exit and waits for it to return.
+func (p *Publisher) Stop() {
+	close(p.stop)
+	<-p.done
+}
+
+// flush drains the retry cache FIFO first, then the current pending batch,
+// and pushes whatever fails back onto the retry cache.
+func (p *Publisher) flush(ctx context.Context) {
+	p.retryMu.Lock()
+	retry := p.retryCache
+	p.retryCache = nil
+	p.retryMu.Unlock()
+
+	p.mu.Lock()
+	batch := p.pending
+	p.pending = nil
+	p.mu.Unlock()
+
+	items := append(retry, batch...)
+	if len(items) == 0 {
+		return
+	}
+
+	if err := p.send(ctx, items); err != nil {
+		p.logger.Printf("telemetry publisher: send failed, caching %d item(s): %v", len(items), err)
+		p.cacheForRetry(items)
+	}
+}
+
+// cacheForRetry appends items to the retry cache, dropping the oldest
+// entries first if the cache would exceed maxRetryCache.
+func (p *Publisher) cacheForRetry(items []Item) {
+	p.retryMu.Lock()
+	defer p.retryMu.Unlock()
+
+	p.retryCache = append(p.retryCache, items...)
+	if overflow := len(p.retryCache) - p.maxRetryCache; overflow > 0 {
+		p.retryCache = p.retryCache[overflow:]
+	}
+}
+
+// send posts items to the upstream collector with exponential backoff and
+// jitter. It makes at most five attempts and returns the last attempt's
+// error, or ctx.Err() if ctx is done while it waits to retry.
+func (p *Publisher) send(ctx context.Context, items []Item) error {
+	body, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("marshal batch: %w", err)
+	}
+
+	backoff := 250 * time.Millisecond
+	for attempt := 0; ; attempt++ {
+		err := p.sendOnce(ctx, body)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= 4 {
+			return err
+		}
+
+		jitter := time.Duration(rand.Int63n(int64(backoff) / 2))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff + jitter):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+func (p *Publisher) sendOnce(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.upstreamURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build upstream request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("upstream request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("upstream returned status %d", resp.StatusCode)
+	}
+	return nil
+}
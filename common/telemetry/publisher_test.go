@@ -0,0 +1,117 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPublisherEnqueueDropsOldestWhenBufferFull(t *testing.T) {
+	p := NewPublisher("http://unused")
+	p.maxBuf = 2
+
+	id1, err := p.Enqueue("first")
+	if err != nil {
+		t.Fatalf("Enqueue(first): %v", err)
+	}
+	if _, err := p.Enqueue("second"); err != nil {
+		t.Fatalf("Enqueue(second): %v", err)
+	}
+	if _, err := p.Enqueue("third"); err != nil {
+		t.Fatalf("Enqueue(third): %v", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.pending) != 2 {
+		t.Fatalf("pending = %d items, want 2", len(p.pending))
+	}
+	if p.pending[0].ID == id1 {
+		t.Fatalf("oldest item (ID %d) was not dropped", id1)
+	}
+}
+
+func TestPublisherCacheForRetryDropsOldestOnOverflow(t *testing.T) {
+	p := NewPublisher("http://unused")
+	p.maxRetryCache = 2
+
+	p.cacheForRetry([]Item{{ID: 1}, {ID: 2}})
+	p.cacheForRetry([]Item{{ID: 3}})
+
+	p.retryMu.Lock()
+	defer p.retryMu.Unlock()
+
+	if len(p.retryCache) != 2 {
+		t.Fatalf("retryCache = %d items, want 2", len(p.retryCache))
+	}
+	if got := []uint64{p.retryCache[0].ID, p.retryCache[1].ID}; got[0] != 2 || got[1] != 3 {
+		t.Fatalf("retryCache IDs = %v, want [2 3]", got)
+	}
+}
+
+func TestPublisherFlushDrainsRetryCacheBeforePending(t *testing.T) {
+	var gotIDs []uint64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var items []Item
+		if err := json.NewDecoder(r.Body).Decode(&items); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+		for _, it := range items {
+			gotIDs = append(gotIDs, it.ID)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := NewPublisher(srv.URL, WithHTTPClient(srv.Client()))
+	p.cacheForRetry([]Item{{ID: 99}})
+	pendingID, err := p.Enqueue("pending")
+	if err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+
+	p.flush(context.Background())
+
+	if len(gotIDs) != 2 || gotIDs[0] != 99 || gotIDs[1] != pendingID {
+		t.Fatalf("delivered IDs = %v, want retry item [99] before the pending item [%d]", gotIDs, pendingID)
+	}
+
+	p.retryMu.Lock()
+	defer p.retryMu.Unlock()
+	if len(p.retryCache) != 0 {
+		t.Fatalf("retryCache = %d items after a successful flush, want 0", len(p.retryCache))
+	}
+}
+
+func TestPublisherFlushRecachesOnSendFailure(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	p := NewPublisher(srv.URL, WithHTTPClient(srv.Client()))
+	if _, err := p.Enqueue("pending"); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	p.flush(ctx)
+
+	if atomic.LoadInt32(&attempts) == 0 {
+		t.Fatal("send was never attempted")
+	}
+
+	p.retryMu.Lock()
+	defer p.retryMu.Unlock()
+	if len(p.retryCache) != 1 {
+		t.Fatalf("retryCache = %d items after a failed flush, want 1", len(p.retryCache))
+	}
+}
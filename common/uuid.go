@@ -0,0 +1,30 @@
+// Package common holds small helpers shared across the telemetry server's
+// packages that don't warrant their own subpackage.
+package common
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"time"
+)
+
+// GenerateUUIDStr returns a random RFC 4122 version 4 UUID string. It is
+// used to mint a request correlation ID when a caller doesn't supply one.
+func GenerateUUIDStr() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// This ID only needs to be unique enough to correlate log lines
+		// for a single request, so a failed system CSPRNG isn't worth
+		// crashing the server over; fall back to something that won't
+		// collide across concurrent requests instead.
+		binary.BigEndian.PutUint64(b[:8], uint64(time.Now().UnixNano()))
+		binary.BigEndian.PutUint64(b[8:], uint64(os.Getpid()))
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
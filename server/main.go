@@ -2,158 +2,257 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+
 	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
 
-	"github.com/v-saba/bazel-tutorial/common"
+	"github.com/v-saba/bazel-tutorial/common/collectors"
+	"github.com/v-saba/bazel-tutorial/common/logger"
+	"github.com/v-saba/bazel-tutorial/common/observability"
+	"github.com/v-saba/bazel-tutorial/common/telemetry"
+)
+
+var (
+	listenAddr = flag.String("listen-addr", ":50051", "address the gRPC server listens on")
+
+	tlsCertFile = flag.String("tls-cert", "", "path to the server TLS certificate (enables TLS when set)")
+	tlsKeyFile  = flag.String("tls-key", "", "path to the server TLS private key (enables TLS when set)")
+	tlsCAFile   = flag.String("tls-client-ca", "", "path to a CA bundle used to verify client certificates (enables mutual TLS when set)")
+
+	keepaliveMaxIdle    = flag.Duration("keepalive-max-idle", 15*time.Minute, "max connection idle time before the server sends GOAWAY")
+	keepaliveTime       = flag.Duration("keepalive-time", 2*time.Hour, "interval between keepalive pings the server sends")
+	keepaliveTimeout    = flag.Duration("keepalive-timeout", 20*time.Second, "time to wait for a keepalive ping ack before closing the connection")
+	gracefulStopTimeout = flag.Duration("graceful-stop-timeout", 10*time.Second, "how long to wait for GracefulStop before forcing Stop")
+
+	collectorURL        = flag.String("collector-url", "", "upstream collector URL that telemetry responses are forwarded to (disabled when empty)")
+	collectorSendPeriod = flag.Duration("collector-send-period", 5*time.Second, "how often buffered telemetry is flushed to the collector")
+	collectorMaxRetry   = flag.Int("collector-max-retry-cache", 5000, "max undelivered items held for retry before the oldest is dropped")
+
+	logConfigFile = flag.String("log-config", "", "path to a YAML logger config (falls back to logger.DefaultConfig when empty)")
+
+	otlpEndpoint = flag.String("otlp-endpoint", "", "OTLP gRPC collector endpoint for trace export (tracing disabled when empty)")
+	metricsAddr  = flag.String("metrics-addr", ":9090", "address the /metrics HTTP listener binds to")
 )
 
 // server implements the gRPC TelemetryServiceServer interface
 type server struct {
 	pb.UnimplementedTelemetryServiceServer
+
+	publisher  *telemetry.Publisher
+	collectors collectors.Registry
 }
 
 // QueryTelemetry implements the gRPC TelemetryServiceServer interface
 func (s *server) QueryTelemetry(ctx context.Context, req *pb.TelemetryRequest) (*pb.TelemetryResponse, error) {
-	log.Printf("Received gRPC telemetry request: %v", req)
+	log := logger.FromContext(ctx)
+	log.Info("received telemetry request", zap.Stringer("telemetry_type", req.TelemetryType))
 
-	// Create response based on the request type
-	response := &pb.TelemetryResponse{
-		TelemetryType: req.TelemetryType,
-		Timestamp:     time.Now().Unix(),
+	response, err := s.buildTelemetryResponse(ctx, req.TelemetryType)
+	if err != nil {
+		log.Warn("failed to build telemetry response", zap.Stringer("telemetry_type", req.TelemetryType), zap.Error(err))
+		return nil, err
 	}
 
-	switch req.TelemetryType {
-	case pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT:
-		response.TelemetryData = &pb.TelemetryResponse_HeartbeatTelemetry{
-			HeartbeatTelemetry: &pb.HeartbeatTelemetry{},
-		}
-	case pb.TelemetryType_TELEMETRY_TYPE_LOG:
-		response.TelemetryData = &pb.TelemetryResponse_LogTelemetry{
-			LogTelemetry: &pb.LogTelemetry{
-				LogData: "Sample log data from gRPC server",
-			},
-		}
-	case pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE:
-		response.TelemetryData = &pb.TelemetryResponse_CpuUsageTelemetry{
-			CpuUsageTelemetry: &pb.CpuUsageTelemetry{
-				CpuUsage: 42.5, // Mock CPU usage
-			},
-		}
-	default:
-		log.Printf("Unknown telemetry type: %v", req.TelemetryType)
-		return nil, fmt.Errorf("unknown telemetry type: %v", req.TelemetryType)
-	}
+	s.enqueue(ctx, response)
 
-	log.Printf("Sending gRPC telemetry response: %v", response)
+	log.Info("sending telemetry response", zap.Stringer("telemetry_type", response.TelemetryType))
 	return response, nil
 }
 
-// ProcessTelemetryRequest processes a telemetry request and returns a response (legacy method)
-func (s *server) ProcessTelemetryRequest(req *pb.TelemetryRequest) *pb.TelemetryResponse {
-	log.Printf("Processing telemetry request: %v", req)
-
-	// Create response based on the request type
-	response := &pb.TelemetryResponse{
-		TelemetryType: req.TelemetryType,
-		Timestamp:     time.Now().Unix(),
+// enqueue forwards response to the configured upstream collector, if any.
+func (s *server) enqueue(ctx context.Context, response *pb.TelemetryResponse) {
+	if s.publisher == nil {
+		return
+	}
+	if _, err := s.publisher.Enqueue(response); err != nil {
+		logger.FromContext(ctx).Warn("failed to enqueue telemetry response for forwarding", zap.Error(err))
 	}
+}
 
-	switch req.TelemetryType {
-	case pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT:
-		response.TelemetryData = &pb.TelemetryResponse_HeartbeatTelemetry{
-			HeartbeatTelemetry: &pb.HeartbeatTelemetry{},
-		}
-	case pb.TelemetryType_TELEMETRY_TYPE_LOG:
-		response.TelemetryData = &pb.TelemetryResponse_LogTelemetry{
-			LogTelemetry: &pb.LogTelemetry{
-				LogData: "Sample log data from server",
-			},
-		}
-	case pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE:
-		response.TelemetryData = &pb.TelemetryResponse_CpuUsageTelemetry{
-			CpuUsageTelemetry: &pb.CpuUsageTelemetry{
-				CpuUsage: 42.5, // Mock CPU usage
-			},
-		}
-	default:
-		log.Printf("Unknown telemetry type: %v", req.TelemetryType)
-		response = nil
+// buildTelemetryResponse produces a single telemetry sample of the given
+// type by dispatching to the registered Collector. It is shared by the
+// unary, server-streaming, and bidi-streaming RPCs so they all sample the
+// same way.
+func (s *server) buildTelemetryResponse(ctx context.Context, telType pb.TelemetryType) (*pb.TelemetryResponse, error) {
+	collector, ok := s.collectors[telType]
+	if !ok {
+		return nil, fmt.Errorf("unknown telemetry type: %v", telType)
 	}
+	return collector.Collect(ctx)
+}
 
-	if response != nil {
-		log.Printf("Generated telemetry response: %v", response)
+// defaultCollectors builds the Collector registry used when the server is
+// not given one explicitly.
+func defaultCollectors() collectors.Registry {
+	return collectors.Registry{
+		pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT: collectors.NewHeartbeatCollector(),
+		pb.TelemetryType_TELEMETRY_TYPE_LOG:       collectors.LogCollector{},
+		pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE: &collectors.CPUCollector{},
+		pb.TelemetryType_TELEMETRY_TYPE_MEMORY:    collectors.NewMemoryCollector(),
 	}
-	return response
 }
 
-func testMessages() {
-	// Test creating basic message types
-	req := &pb.TelemetryRequest{
-		TelemetryType: pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT,
+// newGRPCServer builds the *grpc.Server with server keepalive parameters, the
+// request-correlation logging and tracing/metrics interceptors, and, when
+// TLS flags are set, transport credentials (mutual TLS when a client CA is
+// also configured).
+func newGRPCServer(zapLogger *zap.Logger, metrics *observability.Metrics) (*grpc.Server, error) {
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: *keepaliveMaxIdle,
+			Time:              *keepaliveTime,
+			Timeout:           *keepaliveTimeout,
+		}),
+		grpc.ChainUnaryInterceptor(
+			observability.UnaryServerInterceptor(metrics),
+			logger.UnaryServerInterceptor(zapLogger),
+		),
+		grpc.ChainStreamInterceptor(
+			observability.StreamServerInterceptor(metrics),
+			logger.StreamServerInterceptor(zapLogger),
+		),
 	}
 
-	resp := &pb.TelemetryResponse{
-		TelemetryType: pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT,
-		TelemetryData: &pb.TelemetryResponse_HeartbeatTelemetry{
-			HeartbeatTelemetry: &pb.HeartbeatTelemetry{},
-		},
-		Timestamp: time.Now().Unix(),
+	if *tlsCertFile != "" || *tlsKeyFile != "" {
+		creds, err := loadServerTLSCredentials(*tlsCertFile, *tlsKeyFile, *tlsCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("load TLS credentials: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
 	}
 
-	log.Printf("Test Request: %v", req)
-	log.Printf("Test Response: %v", resp)
+	return grpc.NewServer(opts...), nil
 }
 
 func main() {
-	newUUID := common.GenerateUUIDStr()
-	log.Printf("New UUID: %v", newUUID)
-
-	log.Printf("Telemetry server starting...")
-
-	// Test basic proto messages first
-	testMessages()
+	flag.Parse()
 
-	// Create a server instance
-	srv := &server{}
+	logCfg := logger.DefaultConfig()
+	if *logConfigFile != "" {
+		cfg, err := logger.LoadConfig(*logConfigFile)
+		if err != nil {
+			log.Fatalf("Failed to load log config: %v", err)
+		}
+		logCfg = cfg
+	}
 
-	// Test different telemetry types with both methods
-	telemetryTypes := []pb.TelemetryType{
-		pb.TelemetryType_TELEMETRY_TYPE_HEARTBEAT,
-		pb.TelemetryType_TELEMETRY_TYPE_LOG,
-		pb.TelemetryType_TELEMETRY_TYPE_CPU_USAGE,
+	zapLogger, err := logger.New(logCfg)
+	if err != nil {
+		log.Fatalf("Failed to build logger: %v", err)
 	}
+	defer zapLogger.Sync() //nolint:errcheck
 
-	log.Printf("Testing legacy method...")
-	for _, telType := range telemetryTypes {
-		req := &pb.TelemetryRequest{
-			TelemetryType: telType,
+	if *otlpEndpoint != "" {
+		shutdownTracing, err := observability.InitTracerProvider(context.Background(), "telemetry_server", *otlpEndpoint)
+		if err != nil {
+			zapLogger.Fatal("failed to init tracer provider", zap.Error(err))
 		}
+		defer func() {
+			if err := shutdownTracing(context.Background()); err != nil {
+				zapLogger.Warn("failed to shut down tracer provider", zap.Error(err))
+			}
+		}()
+	}
+
+	metricsRegistry := prometheus.NewRegistry()
+	metrics := observability.NewMetrics(metricsRegistry)
 
-		resp := srv.ProcessTelemetryRequest(req)
-		if resp != nil {
-			log.Printf("Legacy: Successfully processed %v request", telType)
-		} else {
-			log.Printf("Legacy: Failed to process %v request", telType)
+	metricsErrCh := make(chan error, 1)
+	metricsSrv := observability.ServeMetrics(*metricsAddr, metricsRegistry, metricsErrCh)
+	defer func() {
+		if err := observability.ShutdownMetrics(context.Background(), metricsSrv); err != nil {
+			zapLogger.Warn("failed to shut down metrics server", zap.Error(err))
 		}
+	}()
+	zapLogger.Info("metrics server listening", zap.String("addr", *metricsAddr))
+	go func() {
+		if err := <-metricsErrCh; err != nil {
+			zapLogger.Error("metrics server error", zap.Error(err))
+		}
+	}()
+
+	grpcServer, err := newGRPCServer(zapLogger, metrics)
+	if err != nil {
+		zapLogger.Fatal("failed to build gRPC server", zap.Error(err))
 	}
 
-	log.Printf("Testing gRPC method...")
-	for _, telType := range telemetryTypes {
-		req := &pb.TelemetryRequest{
-			TelemetryType: telType,
-		}
+	srv := &server{collectors: defaultCollectors()}
+
+	var publisherCancel context.CancelFunc
+	if *collectorURL != "" {
+		srv.publisher = telemetry.NewPublisher(*collectorURL,
+			telemetry.WithSendPeriod(*collectorSendPeriod),
+			telemetry.WithMaxRetryCache(*collectorMaxRetry),
+			telemetry.WithLogger(zap.NewStdLog(zapLogger)),
+		)
+
+		var publisherCtx context.Context
+		publisherCtx, publisherCancel = context.WithCancel(context.Background())
+		go srv.publisher.Start(publisherCtx)
+	}
 
-		resp, err := srv.QueryTelemetry(context.Background(), req)
+	pb.RegisterTelemetryServiceServer(grpcServer, srv)
+
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthSrv)
+	healthSrv.SetServingStatus("telemetry_server.v1.TelemetryService", healthpb.HealthCheckResponse_SERVING)
+
+	reflection.Register(grpcServer)
+
+	lis, err := net.Listen("tcp", *listenAddr)
+	if err != nil {
+		zapLogger.Fatal("failed to listen", zap.String("addr", *listenAddr), zap.Error(err))
+	}
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		zapLogger.Info("telemetry server listening", zap.String("addr", *listenAddr))
+		serveErrCh <- grpcServer.Serve(lis)
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err := <-serveErrCh:
 		if err != nil {
-			log.Printf("gRPC: Failed to process %v request: %v", telType, err)
-		} else if resp != nil {
-			log.Printf("gRPC: Successfully processed %v request", telType)
+			zapLogger.Fatal("gRPC server exited", zap.Error(err))
+		}
+	case sig := <-sigCh:
+		zapLogger.Info("shutting down gracefully", zap.String("signal", sig.String()), zap.Duration("timeout", *gracefulStopTimeout))
+
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			zapLogger.Info("telemetry server stopped gracefully")
+		case <-time.After(*gracefulStopTimeout):
+			zapLogger.Warn("graceful stop timed out, forcing Stop")
+			grpcServer.Stop()
 		}
 	}
 
-	log.Printf("Server test completed successfully!")
+	if srv.publisher != nil {
+		publisherCancel()
+		srv.publisher.Stop()
+	}
 }
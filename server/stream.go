@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+
+	"github.com/v-saba/bazel-tutorial/common/logger"
+)
+
+var (
+	streamSendBuffer      = flag.Int("stream-send-buffer", 16, "per-stream outbound buffer size before samples are dropped")
+	minStreamInterval     = flag.Duration("min-stream-interval", 250*time.Millisecond, "lower bound clamped onto client-requested sample intervals")
+	defaultStreamInterval = flag.Duration("default-stream-interval", 5*time.Second, "sample interval used when a client does not specify one")
+)
+
+// SubscribeTelemetry implements the gRPC TelemetryServiceServer interface.
+// It samples req.TelemetryType once synchronously, so a request for an
+// unknown type fails the RPC immediately rather than hanging, then starts
+// the same ticker-driven producer TelemetryChannel uses for the rest of the
+// stream, buffering responses on a channel so a slow client has its oldest
+// pending sample dropped rather than stalling the producer or erroring out
+// the stream.
+func (s *server) SubscribeTelemetry(req *pb.SubscribeTelemetryRequest, stream pb.TelemetryService_SubscribeTelemetryServer) error {
+	interval := time.Duration(req.GetIntervalMillis()) * time.Millisecond
+	switch {
+	case req.GetIntervalMillis() == 0:
+		interval = *defaultStreamInterval
+	case interval < *minStreamInterval:
+		interval = *minStreamInterval
+	}
+
+	ctx := stream.Context()
+
+	resp, err := s.buildTelemetryResponse(ctx, req.GetTelemetryType())
+	if err != nil {
+		return err
+	}
+	s.enqueue(ctx, resp)
+	if err := stream.Send(resp); err != nil {
+		return err
+	}
+
+	out := make(chan *pb.TelemetryResponse, *streamSendBuffer)
+	go s.produceTelemetryChannel(ctx, req.GetTelemetryType(), interval, out)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case resp := <-out:
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// TelemetryChannel implements the gRPC TelemetryServiceServer interface. It
+// reads telemetry requests from the client and, for each one, starts a
+// ticker-driven producer that samples that type and writes responses back
+// on a shared, buffered channel. Sends to a full buffer drop the oldest
+// pending sample rather than block the producers.
+func (s *server) TelemetryChannel(stream pb.TelemetryService_TelemetryChannelServer) error {
+	ctx := stream.Context()
+	out := make(chan *pb.TelemetryResponse, *streamSendBuffer)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case resp := <-out:
+				if err := stream.Send(resp); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		go s.produceTelemetryChannel(ctx, req.GetTelemetryType(), *defaultStreamInterval, out)
+	}
+}
+
+// produceTelemetryChannel samples telType on a ticker and pushes responses
+// onto out, dropping the oldest buffered response when out is full so a
+// slow receiver cannot stall the producer. It is shared by SubscribeTelemetry
+// and TelemetryChannel.
+func (s *server) produceTelemetryChannel(ctx context.Context, telType pb.TelemetryType, interval time.Duration, out chan *pb.TelemetryResponse) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			resp, err := s.buildTelemetryResponse(ctx, telType)
+			if err != nil {
+				logger.FromContext(ctx).Warn("failed to build telemetry response", zap.Stringer("telemetry_type", telType), zap.Error(err))
+				continue
+			}
+			s.enqueue(ctx, resp)
+			sendOrDropOldest(out, resp)
+		}
+	}
+}
+
+// sendOrDropOldest sends resp on out, or, if out is full, drops the oldest
+// buffered response to make room rather than block the producer or the
+// caller.
+func sendOrDropOldest(out chan *pb.TelemetryResponse, resp *pb.TelemetryResponse) {
+	select {
+	case out <- resp:
+	default:
+		select {
+		case <-out:
+		default:
+		}
+		select {
+		case out <- resp:
+		default:
+		}
+	}
+}
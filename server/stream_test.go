@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/v-saba/bazel-tutorial/proto/gen/go/telemetry_server/v1"
+)
+
+func TestSendOrDropOldestFillsBuffer(t *testing.T) {
+	out := make(chan *pb.TelemetryResponse, 2)
+	first := &pb.TelemetryResponse{Timestamp: 1}
+	second := &pb.TelemetryResponse{Timestamp: 2}
+
+	sendOrDropOldest(out, first)
+	sendOrDropOldest(out, second)
+
+	if len(out) != 2 {
+		t.Fatalf("len(out) = %d, want 2", len(out))
+	}
+}
+
+func TestSendOrDropOldestDropsOldestWhenFull(t *testing.T) {
+	out := make(chan *pb.TelemetryResponse, 2)
+	oldest := &pb.TelemetryResponse{Timestamp: 1}
+	middle := &pb.TelemetryResponse{Timestamp: 2}
+	newest := &pb.TelemetryResponse{Timestamp: 3}
+
+	sendOrDropOldest(out, oldest)
+	sendOrDropOldest(out, middle)
+	sendOrDropOldest(out, newest)
+
+	if len(out) != 2 {
+		t.Fatalf("len(out) = %d, want 2", len(out))
+	}
+
+	got := []*pb.TelemetryResponse{<-out, <-out}
+	if got[0] != middle || got[1] != newest {
+		t.Fatalf("out = %v, want [middle newest] (oldest dropped)", got)
+	}
+}